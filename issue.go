@@ -63,6 +63,7 @@ var ruleToCWE = map[string]string{
 	"G108": "200",
 	"G109": "190",
 	"G110": "409",
+	"G111": "400",
 	"G201": "89",
 	"G202": "89",
 	"G203": "79",
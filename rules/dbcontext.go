@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"go/ast"
+
+	"github.com/securego/gosec/v2"
+)
+
+type dbContextCheck struct {
+	gosec.MetaData
+	gosec.CallList
+	// bodies of the functions currently in scope which receive a context
+	ctxBodies []*ast.BlockStmt
+}
+
+func (r *dbContextCheck) ID() string {
+	return r.MetaData.ID
+}
+
+// hasContextParam checks if one of the function parameters is a usable context.Context
+func hasContextParam(fn *ast.FuncType, c *gosec.Context) bool {
+	if fn.Params == nil {
+		return false
+	}
+	for _, field := range fn.Params.List {
+		typ := c.Info.TypeOf(field.Type)
+		if typ == nil || typ.String() != "context.Context" {
+			continue
+		}
+		for _, name := range field.Names {
+			if name.Name != "_" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *dbContextCheck) Match(n ast.Node, c *gosec.Context) (*gosec.Issue, error) {
+	switch node := n.(type) {
+	case *ast.FuncDecl:
+		// a new top level function starts, none of the previous contexts is in scope anymore
+		r.ctxBodies = nil
+		if node.Body != nil && hasContextParam(node.Type, c) {
+			r.ctxBodies = append(r.ctxBodies, node.Body)
+		}
+	case *ast.FuncLit:
+		if hasContextParam(node.Type, c) {
+			r.ctxBodies = append(r.ctxBodies, node.Body)
+		}
+	case *ast.CallExpr:
+		if r.ContainsCallExpr(node, c) == nil {
+			return nil, nil
+		}
+		for _, body := range r.ctxBodies {
+			if body.Pos() <= node.Pos() && node.End() <= body.End() {
+				return gosec.NewIssue(c, n, r.ID(), r.What, r.Severity, r.Confidence), nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// NewDBContextCheck detects database calls which ignore the context received by the
+// enclosing function although a variant accepting a context exists
+func NewDBContextCheck(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
+	rule := &dbContextCheck{
+		CallList: gosec.NewCallList(),
+		MetaData: gosec.MetaData{
+			ID:         id,
+			What:       "Database call ignores the available context, use the Context variant instead",
+			Severity:   gosec.Low,
+			Confidence: gosec.High,
+		},
+	}
+	rule.AddAll("*database/sql.DB", "Begin", "Exec", "Ping", "Prepare", "Query", "QueryRow")
+	rule.AddAll("*database/sql.Tx", "Exec", "Prepare", "Query", "QueryRow", "Stmt")
+	rule.AddAll("*database/sql.Stmt", "Exec", "Query", "QueryRow")
+	return rule, []ast.Node{(*ast.FuncDecl)(nil), (*ast.FuncLit)(nil), (*ast.CallExpr)(nil)}
+}
@@ -68,6 +68,7 @@ func Generate(filters ...RuleFilter) RuleList {
 		{"G108", "Profiling endpoint is automatically exposed", NewPprofCheck},
 		{"G109", "Converting strconv.Atoi result to int32/int16", NewIntegerOverflowCheck},
 		{"G110", "Detect io.Copy instead of io.CopyN when decompression", NewDecompressionBombCheck},
+		{"G111", "Database call ignores the available context", NewDBContextCheck},
 
 		// injection
 		{"G201", "SQL query construction using format string", NewSQLStrFormat},
@@ -16,6 +16,7 @@ package rules
 
 import (
 	"go/ast"
+	"go/types"
 	"regexp"
 	"strings"
 
@@ -46,6 +47,7 @@ func (s *sqlStatement) MatchPatterns(str string) bool {
 
 type sqlStrConcat struct {
 	sqlStatement
+	noIssue gosec.CallList
 }
 
 func (s *sqlStrConcat) ID() string {
@@ -95,6 +97,9 @@ func (s *sqlStrConcat) checkQuery(call *ast.CallExpr, ctx *gosec.Context) (*gose
 				if op, ok := op.(*ast.Ident); ok && s.checkObject(op, ctx) {
 					continue
 				}
+				if s.noIssue.ContainsPkgCallExpr(op, ctx, true) != nil {
+					continue
+				}
 				return gosec.NewIssue(ctx, be, s.ID(), s.What, s.Severity, s.Confidence), nil
 			}
 		}
@@ -116,6 +121,14 @@ func (s *sqlStrConcat) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, erro
 		if sqlQueryCall, ok := stmt.X.(*ast.CallExpr); ok && s.ContainsCallExpr(stmt.X, ctx) != nil {
 			return s.checkQuery(sqlQueryCall, ctx)
 		}
+	case *ast.DeferStmt:
+		if s.ContainsCallExpr(stmt.Call, ctx) != nil {
+			return s.checkQuery(stmt.Call, ctx)
+		}
+	case *ast.GoStmt:
+		if s.ContainsCallExpr(stmt.Call, ctx) != nil {
+			return s.checkQuery(stmt.Call, ctx)
+		}
 	}
 	return nil, nil
 }
@@ -135,11 +148,16 @@ func NewSQLStrConcat(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
 			},
 			CallList: gosec.NewCallList(),
 		},
+		noIssue: gosec.NewCallList(),
 	}
 
-	rule.AddAll("*database/sql.DB", "Query", "QueryContext", "QueryRow", "QueryRowContext")
-	rule.AddAll("*database/sql.Tx", "Query", "QueryContext", "QueryRow", "QueryRowContext")
-	return rule, []ast.Node{(*ast.AssignStmt)(nil), (*ast.ExprStmt)(nil)}
+	rule.AddAll("*database/sql.DB", "Query", "QueryContext", "QueryRow", "QueryRowContext", "Exec", "ExecContext", "Prepare", "PrepareContext")
+	rule.AddAll("*database/sql.Tx", "Query", "QueryContext", "QueryRow", "QueryRowContext", "Exec", "ExecContext", "Prepare", "PrepareContext")
+	rule.AddAll("*database/sql.Conn", "QueryContext", "QueryRowContext", "ExecContext", "PrepareContext")
+	// numeric and boolean values cannot carry an injection, unlike strconv.Quote
+	// which produces a Go string literal rather than a SQL one
+	rule.noIssue.AddAll("strconv", "Itoa", "FormatInt", "FormatUint", "FormatFloat", "FormatBool")
+	return rule, []ast.Node{(*ast.AssignStmt)(nil), (*ast.ExprStmt)(nil), (*ast.DeferStmt)(nil), (*ast.GoStmt)(nil)}
 }
 
 type sqlStrFormat struct {
@@ -197,6 +215,56 @@ func (s *sqlStrFormat) checkQuery(call *ast.CallExpr, ctx *gosec.Context) (*gose
 	return nil, nil
 }
 
+// checkConcat handles fmt.Sprint and fmt.Sprintln, which concatenate their operands
+// instead of formatting them. The string literals are joined with the separators
+// fmt would add to rebuild the query, which is then matched only against the SQL
+// keywords pattern.
+func (s *sqlStrFormat) checkConcat(n ast.Node, call *ast.CallExpr, ctx *gosec.Context) *gosec.Issue {
+	var query strings.Builder
+	unsafe := false
+	// Sprintln always separates operands with a space, Sprint only when neither is a string
+	newline := false
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		newline = sel.Sel.Name == "Sprintln"
+	}
+	prevString := false
+	for i, arg := range call.Args {
+		isBasic, isString := false, false
+		if typ := ctx.Info.TypeOf(arg); typ != nil {
+			if basic, ok := typ.Underlying().(*types.Basic); ok {
+				isBasic = true
+				isString = basic.Info()&types.IsString != 0
+			}
+		}
+		if i > 0 && (newline || (!prevString && !isString)) {
+			query.WriteString(" ")
+		}
+		prevString = isString
+
+		if argExpr, ok := arg.(*ast.BinaryExpr); ok {
+			if str, ok := gosec.ConcatString(argExpr); ok {
+				query.WriteString(str)
+				continue
+			}
+		} else if str, err := gosec.GetString(arg); err == nil {
+			query.WriteString(str)
+			continue
+		}
+		if s.noIssueQuoted.ContainsPkgCallExpr(arg, ctx, true) != nil || s.constObject(arg, ctx) {
+			continue
+		}
+		// numeric and boolean operands cannot carry an injection, as with %d
+		if isBasic && !isString {
+			continue
+		}
+		unsafe = true
+	}
+	if unsafe && s.patterns[0].MatchString(query.String()) {
+		return gosec.NewIssue(ctx, n, s.ID(), s.What, s.Severity, s.Confidence)
+	}
+	return nil
+}
+
 func (s *sqlStrFormat) checkFormatting(n ast.Node, ctx *gosec.Context) *gosec.Issue {
 	// argIndex changes the function argument which gets matched to the regex
 	argIndex := 0
@@ -215,6 +283,9 @@ func (s *sqlStrFormat) checkFormatting(n ast.Node, ctx *gosec.Context) *gosec.Is
 				// the function is Fprintf so set argIndex = 1
 				argIndex = 1
 			}
+			if sel.Sel.Name == "Sprint" || sel.Sel.Name == "Sprintln" {
+				return s.checkConcat(n, node, ctx)
+			}
 		}
 
 		// no formatter
@@ -269,6 +340,14 @@ func (s *sqlStrFormat) Match(n ast.Node, ctx *gosec.Context) (*gosec.Issue, erro
 		if sqlQueryCall, ok := stmt.X.(*ast.CallExpr); ok && s.ContainsCallExpr(stmt.X, ctx) != nil {
 			return s.checkQuery(sqlQueryCall, ctx)
 		}
+	case *ast.DeferStmt:
+		if s.ContainsCallExpr(stmt.Call, ctx) != nil {
+			return s.checkQuery(stmt.Call, ctx)
+		}
+	case *ast.GoStmt:
+		if s.ContainsCallExpr(stmt.Call, ctx) != nil {
+			return s.checkQuery(stmt.Call, ctx)
+		}
 	}
 	return nil, nil
 }
@@ -293,11 +372,15 @@ func NewSQLStrFormat(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
 			},
 		},
 	}
-	rule.AddAll("*database/sql.DB", "Query", "QueryContext", "QueryRow", "QueryRowContext")
-	rule.AddAll("*database/sql.Tx", "Query", "QueryContext", "QueryRow", "QueryRowContext")
+	rule.AddAll("*database/sql.DB", "Query", "QueryContext", "QueryRow", "QueryRowContext", "Exec", "ExecContext", "Prepare", "PrepareContext")
+	rule.AddAll("*database/sql.Tx", "Query", "QueryContext", "QueryRow", "QueryRowContext", "Exec", "ExecContext", "Prepare", "PrepareContext")
+	rule.AddAll("*database/sql.Conn", "QueryContext", "QueryRowContext", "ExecContext", "PrepareContext")
 	rule.fmtCalls.AddAll("fmt", "Sprint", "Sprintf", "Sprintln", "Fprintf")
 	rule.noIssue.AddAll("os", "Stdout", "Stderr")
 	rule.noIssueQuoted.Add("github.com/lib/pq", "QuoteIdentifier")
+	// numeric and boolean values cannot carry an injection, unlike strconv.Quote
+	// which produces a Go string literal rather than a SQL one
+	rule.noIssueQuoted.AddAll("strconv", "Itoa", "FormatInt", "FormatUint", "FormatFloat", "FormatBool")
 
-	return rule, []ast.Node{(*ast.AssignStmt)(nil), (*ast.ExprStmt)(nil)}
+	return rule, []ast.Node{(*ast.AssignStmt)(nil), (*ast.ExprStmt)(nil), (*ast.DeferStmt)(nil), (*ast.GoStmt)(nil)}
 }
@@ -17,11 +17,25 @@ func (r *ssrf) ID() string {
 	return r.MetaData.ID
 }
 
-// ResolveVar tries to resolve the first argument of a call expression
-// The first argument is the url
+// urlArgIndex returns the position of the url argument for the given call.
+// The url is the first argument except for the request constructors which take
+// the method (and the context) first.
+func urlArgIndex(n *ast.CallExpr, c *gosec.Context) int {
+	if _, fn, err := gosec.GetCallInfo(n, c); err == nil {
+		switch fn {
+		case "NewRequest":
+			return 1
+		case "NewRequestWithContext":
+			return 2
+		}
+	}
+	return 0
+}
+
+// ResolveVar tries to resolve the url argument of a call expression
 func (r *ssrf) ResolveVar(n *ast.CallExpr, c *gosec.Context) bool {
-	if len(n.Args) > 0 {
-		arg := n.Args[0]
+	if idx := urlArgIndex(n, c); len(n.Args) > idx {
+		arg := n.Args[idx]
 		if ident, ok := arg.(*ast.Ident); ok {
 			obj := c.Info.ObjectOf(ident)
 			if _, ok := obj.(*types.Var); ok {
@@ -61,6 +75,6 @@ func NewSSRFCheck(id string, conf gosec.Config) (gosec.Rule, []ast.Node) {
 			Confidence: gosec.Medium,
 		},
 	}
-	rule.AddAll("net/http", "Do", "Get", "Head", "Post", "PostForm", "RoundTrip")
+	rule.AddAll("net/http", "Do", "Get", "Head", "Post", "PostForm", "RoundTrip", "NewRequest", "NewRequestWithContext")
 	return rule, []ast.Node{(*ast.CallExpr)(nil)}
 }
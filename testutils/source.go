@@ -569,7 +569,68 @@ func get(url string) {
 func main() {
 	url := "http://127.0.0.1"
 	get(url)
-}`}, 1, gosec.NewConfig()}}
+}`}, 1, gosec.NewConfig()}, {[]string{`
+// An url from the environment used to build a request is not secure
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+)
+func main() {
+	url := os.Getenv("tainted_url")
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		panic(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(resp.Status)
+}`}, 1, gosec.NewConfig()}, {[]string{`
+// An url from the environment used to build a request with a context is not secure
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+)
+func main() {
+	url := os.Getenv("tainted_url")
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	if err != nil {
+		panic(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(resp.Status)
+}`}, 1, gosec.NewConfig()}, {[]string{`
+// A constant url used to build a request with a context is secure
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+const url = "http://127.0.0.1"
+func main() {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	if err != nil {
+		panic(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(resp.Status)
+}`}, 0, gosec.NewConfig()}}
 
 	// SampleCodeG108 - pprof endpoint automatically exposed
 	SampleCodeG108 = []CodeSample{{[]string{`
@@ -781,36 +842,469 @@ func main() {
 	}
 }`}, 1, gosec.NewConfig()}, {[]string{`
 package main
-
+
+import (
+	"io"
+	"os"
+)
+
+func main() {
+	s, err := os.Open("src")
+	if err != nil {
+		panic(err)
+	}
+	defer s.Close()
+
+	d, err := os.Create("dst")
+	if err != nil {
+		panic(err)
+	}
+	defer d.Close()
+
+	_, err = io.Copy(d, s)
+	if  err != nil {
+		panic(err)
+	}
+}`}, 0, gosec.NewConfig()},
+	}
+
+	// SampleCodeG111 - database call ignoring the available context
+	SampleCodeG111 = []CodeSample{{[]string{`
+package main
+
+import (
+	"context"
+	"database/sql"
+)
+
+func names(ctx context.Context, db *sql.DB, ids []int) error {
+	for _, id := range ids {
+		rows, err := db.Query("SELECT name FROM foo WHERE id = ?", id)
+		if err != nil {
+			return err
+		}
+		rows.Close()
+	}
+	return nil
+}
+
+func main() {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	if err := names(context.Background(), db, []int{1, 2}); err != nil {
+		panic(err)
+	}
+}`}, 1, gosec.NewConfig()}, {[]string{`
+// the context is also in scope inside a closure
+package main
+
+import (
+	"context"
+	"database/sql"
+)
+
+func update(ctx context.Context, db *sql.DB) error {
+	return func() error {
+		tx, err := db.Begin()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec("UPDATE foo SET name = 'bar'"); err != nil {
+			return tx.Rollback()
+		}
+		return tx.Commit()
+	}()
+}
+
+func main() {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	if err := update(context.Background(), db); err != nil {
+		panic(err)
+	}
+}`}, 2, gosec.NewConfig()}, {[]string{`
+package main
+
+import (
+	"context"
+	"database/sql"
+)
+
+func names(ctx context.Context, db *sql.DB, ids []int) error {
+	for _, id := range ids {
+		rows, err := db.QueryContext(ctx, "SELECT name FROM foo WHERE id = ?", id)
+		if err != nil {
+			return err
+		}
+		rows.Close()
+	}
+	return nil
+}
+
+func main() {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	if err := names(context.Background(), db, []int{1, 2}); err != nil {
+		panic(err)
+	}
+}`}, 0, gosec.NewConfig()}, {[]string{`
+// no context is available
+package main
+
+import (
+	"database/sql"
+)
+
+func names(db *sql.DB, ids []int) error {
+	for _, id := range ids {
+		rows, err := db.Query("SELECT name FROM foo WHERE id = ?", id)
+		if err != nil {
+			return err
+		}
+		rows.Close()
+	}
+	return nil
+}
+
+func main() {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	if err := names(db, []int{1, 2}); err != nil {
+		panic(err)
+	}
+}`}, 0, gosec.NewConfig()}, {[]string{`
+// the context is ignored by the function itself
+package main
+
+import (
+	"context"
+	"database/sql"
+)
+
+func ping(_ context.Context, db *sql.DB) error {
+	return db.Ping()
+}
+
+func main() {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	if err := ping(context.Background(), db); err != nil {
+		panic(err)
+	}
+}`}, 0, gosec.NewConfig()}}
+
+	// SampleCodeG201 - SQL injection via format string
+	SampleCodeG201 = []CodeSample{
+		{[]string{`
+// Format string without proper quoting
+package main
+import (
+	"database/sql"
+	"fmt"
+	"os"
+)
+
+func main(){
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	q := fmt.Sprintf("SELECT * FROM foo where name = '%s'", os.Args[1])
+	rows, err := db.Query(q)
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+}`}, 1, gosec.NewConfig()}, {[]string{`
+// Format string used by a deferred and a concurrent query
+package main
+import (
+	"database/sql"
+	"fmt"
+	"os"
+)
+
+func main(){
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	q := fmt.Sprintf("SELECT * FROM foo where name = '%s'", os.Args[1])
+	defer db.Query(q)
+	go db.QueryRow(q)
+}`}, 2, gosec.NewConfig()}, {[]string{`
+// Format string used in a transaction and a prepared statement
+package main
+import (
+	"database/sql"
+	"fmt"
+	"os"
+)
+
+func main(){
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		panic(err)
+	}
+	defer tx.Rollback()
+	q := fmt.Sprintf("DELETE FROM foo where name = '%s'", os.Args[1])
+	if _, err := tx.Exec(q); err != nil {
+		panic(err)
+	}
+	stmt, err := db.Prepare(q)
+	if err != nil {
+		panic(err)
+	}
+	defer stmt.Close()
+}`}, 2, gosec.NewConfig()}, {[]string{`
+// Format string without proper quoting case insensitive
+package main
+import (
+	"database/sql"
+	"fmt"
+	"os"
+)
+
+func main(){
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	q := fmt.Sprintf("select * from foo where name = '%s'", os.Args[1])
+	rows, err := db.Query(q)
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+}`}, 1, gosec.NewConfig()}, {[]string{`
+// Format string without proper quoting with context
+package main
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+)
+
+func main(){
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	q := fmt.Sprintf("select * from foo where name = '%s'", os.Args[1])
+	rows, err := db.QueryContext(context.Background(), q)
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+}`}, 1, gosec.NewConfig()}, {[]string{`
+// Format string without proper quoting with transaction
+package main
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+)
+
+func main(){
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		panic(err)
+	}
+	defer tx.Rollback()
+	q := fmt.Sprintf("select * from foo where name = '%s'", os.Args[1])
+	rows, err := tx.QueryContext(context.Background(), q)
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+	if err := tx.Commit(); err != nil {
+		panic(err)
+	}
+}`}, 1, gosec.NewConfig()}, {[]string{`
+// Format string false positive, safe string spec.
+package main
+import (
+	"database/sql"
+	"fmt"
+	"os"
+)
+
+func main(){
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	q := fmt.Sprintf("SELECT * FROM foo where id = %d", os.Args[1])
+	rows, err := db.Query(q)
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+}`}, 0, gosec.NewConfig()}, {[]string{`
+// Format string false positive
+package main
+import (
+		"database/sql"
+)
+const staticQuery = "SELECT * FROM foo WHERE age < 32"
+func main(){
+		db, err := sql.Open("sqlite3", ":memory:")
+		if err != nil {
+			panic(err)
+		}
+		rows, err := db.Query(staticQuery)
+		if err != nil {
+			panic(err)
+		}
+		defer rows.Close()
+}`}, 0, gosec.NewConfig()}, {[]string{`
+// Format string false positive, quoted formatter argument.
+package main
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"github.com/lib/pq"
+)
+
+func main(){
+	db, err := sql.Open("postgres", "localhost")
+	if err != nil {
+		panic(err)
+	}
+	q := fmt.Sprintf("SELECT * FROM %s where id = 1", pq.QuoteIdentifier(os.Args[1]))
+	rows, err := db.Query(q)
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+}`}, 0, gosec.NewConfig()}, {[]string{`
+// Replacing quotes is not enough to make the formatter argument safe
+package main
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main(){
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	q := fmt.Sprintf("SELECT * FROM foo where name = '%s'", strings.ReplaceAll(os.Args[1], "'", "''"))
+	rows, err := db.Query(q)
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+}`}, 1, gosec.NewConfig()}, {[]string{`
+// Parameterized query
+package main
+import (
+	"database/sql"
+	"os"
+)
+
+func main(){
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	rows, err := db.Query("SELECT * FROM foo where name = ?", os.Args[1])
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+}`}, 0, gosec.NewConfig()}, {[]string{`
+// Numeric formatter argument
+package main
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+func main(){
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	id, err := strconv.Atoi(os.Args[1])
+	if err != nil {
+		panic(err)
+	}
+	q := fmt.Sprintf("SELECT * FROM foo where id = %s", strconv.Itoa(id))
+	rows, err := db.Query(q)
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+}`}, 0, gosec.NewConfig()}, {[]string{`
+// Go quoting is not SQL quoting
+package main
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+func main(){
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	q := fmt.Sprintf("SELECT * FROM foo where name = %s", strconv.Quote(os.Args[1]))
+	rows, err := db.Query(q)
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+}`}, 1, gosec.NewConfig()}, {[]string{`
+// false positive
+package main
 import (
-	"io"
-	"os"
+	"database/sql"
+	"fmt"
 )
 
-func main() {
-	s, err := os.Open("src")
+const Table = "foo"
+func main(){
+	db, err := sql.Open("sqlite3", ":memory:")
 	if err != nil {
 		panic(err)
 	}
-	defer s.Close()
-
-	d, err := os.Create("dst")
+	q := fmt.Sprintf("SELECT * FROM %s where id = 1", Table)
+	rows, err := db.Query(q)
 	if err != nil {
 		panic(err)
 	}
-	defer d.Close()
-
-	_, err = io.Copy(d, s)
-	if  err != nil {
-		panic(err)
-	}
-}`}, 0, gosec.NewConfig()},
-	}
-
-	// SampleCodeG201 - SQL injection via format string
-	SampleCodeG201 = []CodeSample{
-		{[]string{`
-// Format string without proper quoting
+	defer rows.Close()
+}`}, 0, gosec.NewConfig()}, {[]string{`
+// Query built by concatenating the operands of fmt.Sprint
 package main
 import (
 	"database/sql"
@@ -823,14 +1317,14 @@ func main(){
 	if err != nil {
 		panic(err)
 	}
-	q := fmt.Sprintf("SELECT * FROM foo where name = '%s'", os.Args[1])
+	q := fmt.Sprint("SELECT * FROM foo where name = '", os.Args[1], "'")
 	rows, err := db.Query(q)
 	if err != nil {
 		panic(err)
 	}
 	defer rows.Close()
 }`}, 1, gosec.NewConfig()}, {[]string{`
-// Format string without proper quoting case insensitive
+// Query built by concatenating the operands of fmt.Sprintln
 package main
 import (
 	"database/sql"
@@ -843,41 +1337,38 @@ func main(){
 	if err != nil {
 		panic(err)
 	}
-	q := fmt.Sprintf("select * from foo where name = '%s'", os.Args[1])
+	q := fmt.Sprintln("SELECT * FROM foo where name =", os.Args[1])
 	rows, err := db.Query(q)
 	if err != nil {
 		panic(err)
 	}
 	defer rows.Close()
 }`}, 1, gosec.NewConfig()}, {[]string{`
-// Format string without proper quoting with context
+// false positive
 package main
 import (
-	"context"
 	"database/sql"
 	"fmt"
-	"os"
 )
 
+const Table = "foo"
 func main(){
 	db, err := sql.Open("sqlite3", ":memory:")
 	if err != nil {
 		panic(err)
 	}
-	q := fmt.Sprintf("select * from foo where name = '%s'", os.Args[1])
-	rows, err := db.QueryContext(context.Background(), q)
+	q := fmt.Sprint("SELECT * FROM ", Table, " where id = 1")
+	rows, err := db.Query(q)
 	if err != nil {
 		panic(err)
 	}
 	defer rows.Close()
-}`}, 1, gosec.NewConfig()}, {[]string{`
-// Format string without proper quoting with transaction
+}`}, 0, gosec.NewConfig()}, {[]string{`
+// false positive
 package main
 import (
-	"context"
 	"database/sql"
 	"fmt"
-	"os"
 )
 
 func main(){
@@ -885,22 +1376,33 @@ func main(){
 	if err != nil {
 		panic(err)
 	}
-	tx, err := db.Begin()
+	q := fmt.Sprint("SELECT * FROM foo")
+	rows, err := db.Query(q)
 	if err != nil {
 		panic(err)
 	}
-	defer tx.Rollback()
-	q := fmt.Sprintf("select * from foo where name = '%s'", os.Args[1])
-	rows, err := tx.QueryContext(context.Background(), q)
+	defer rows.Close()
+}`}, 0, gosec.NewConfig()}, {[]string{`
+// false positive
+package main
+import (
+	"database/sql"
+	"fmt"
+)
+
+func main(){
+	db, err := sql.Open("sqlite3", ":memory:")
 	if err != nil {
 		panic(err)
 	}
-	defer rows.Close()
-	if err := tx.Commit(); err != nil {
+	q := fmt.Sprintln("SELECT * FROM foo")
+	rows, err := db.Query(q)
+	if err != nil {
 		panic(err)
 	}
-}`}, 1, gosec.NewConfig()}, {[]string{`
-// Format string false positive, safe string spec.
+	defer rows.Close()
+}`}, 0, gosec.NewConfig()}, {[]string{`
+// false positive
 package main
 import (
 	"database/sql"
@@ -913,65 +1415,71 @@ func main(){
 	if err != nil {
 		panic(err)
 	}
-	q := fmt.Sprintf("SELECT * FROM foo where id = %d", os.Args[1])
+	id := len(os.Args)
+	q := fmt.Sprint("SELECT * FROM foo where id = ", id)
 	rows, err := db.Query(q)
 	if err != nil {
 		panic(err)
 	}
 	defer rows.Close()
 }`}, 0, gosec.NewConfig()}, {[]string{`
-// Format string false positive
+// SQL keywords split across the concatenated literal operands
 package main
 import (
-		"database/sql"
+	"database/sql"
+	"fmt"
+	"os"
 )
-const staticQuery = "SELECT * FROM foo WHERE age < 32"
+
 func main(){
-		db, err := sql.Open("sqlite3", ":memory:")
-		if err != nil {
-			panic(err)
-		}
-		rows, err := db.Query(staticQuery)
-		if err != nil {
-			panic(err)
-		}
-		defer rows.Close()
-}`}, 0, gosec.NewConfig()}, {[]string{`
-// Format string false positive, quoted formatter argument.
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	q := fmt.Sprint(os.Args[1], " FROM foo where id = 1")
+	rows, err := db.Query(q)
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+}`}, 1, gosec.NewConfig()}, {[]string{`
+// SQL keywords split across operands separated by fmt.Sprintln
 package main
 import (
 	"database/sql"
 	"fmt"
 	"os"
-	"github.com/lib/pq"
 )
 
 func main(){
-	db, err := sql.Open("postgres", "localhost")
+	db, err := sql.Open("sqlite3", ":memory:")
 	if err != nil {
 		panic(err)
 	}
-	q := fmt.Sprintf("SELECT * FROM %s where id = 1", pq.QuoteIdentifier(os.Args[1]))
+	q := fmt.Sprintln("SELECT", "*", "FROM", "foo", "WHERE", "name =", os.Args[1])
 	rows, err := db.Query(q)
 	if err != nil {
 		panic(err)
 	}
 	defer rows.Close()
-}`}, 0, gosec.NewConfig()}, {[]string{`
+}`}, 1, gosec.NewConfig()}, {[]string{`
 // false positive
 package main
 import (
 	"database/sql"
 	"fmt"
+	"os"
 )
 
-const Table = "foo"
+type ID int64
+
 func main(){
 	db, err := sql.Open("sqlite3", ":memory:")
 	if err != nil {
 		panic(err)
 	}
-	q := fmt.Sprintf("SELECT * FROM %s where id = 1", Table)
+	id := ID(len(os.Args))
+	q := fmt.Sprint("SELECT * FROM foo where id = ", id)
 	rows, err := db.Query(q)
 	if err != nil {
 		panic(err)
@@ -1024,6 +1532,96 @@ func main(){
 	}
 	defer rows.Close()
 }`}, 1, gosec.NewConfig()}, {[]string{`
+// numeric formatter operand
+package main
+import (
+	"database/sql"
+	"os"
+	"strconv"
+)
+func main(){
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	id, err := strconv.Atoi(os.Args[1])
+	if err != nil {
+		panic(err)
+	}
+	rows, err := db.Query("SELECT * FROM foo WHERE id = " + strconv.Itoa(id))
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+}`}, 0, gosec.NewConfig()}, {[]string{`
+// Go quoting is not SQL quoting
+package main
+import (
+	"database/sql"
+	"os"
+	"strconv"
+)
+func main(){
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	rows, err := db.Query("SELECT * FROM foo WHERE name = " + strconv.Quote(os.Args[1]))
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+}`}, 1, gosec.NewConfig()}, {[]string{`
+// deferred and concurrent queries
+package main
+import (
+	"database/sql"
+	"os"
+)
+func main(){
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	defer db.Query("SELECT * FROM foo WHERE name = " + os.Args[1])
+	go db.QueryRow("SELECT * FROM foo WHERE name = " + os.Args[1])
+}`}, 2, gosec.NewConfig()}, {[]string{`
+// transactions, prepared statements and connections
+package main
+import (
+	"context"
+	"database/sql"
+	"os"
+)
+func main(){
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		panic(err)
+	}
+	defer tx.Rollback()
+	_, err = tx.Exec("DELETE FROM foo WHERE name = " + os.Args[1])
+	if err != nil {
+		panic(err)
+	}
+	stmt, err := db.Prepare("SELECT * FROM foo WHERE name = " + os.Args[1])
+	if err != nil {
+		panic(err)
+	}
+	defer stmt.Close()
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+	_, err = conn.ExecContext(context.Background(), "UPDATE foo SET name = " + os.Args[1])
+	if err != nil {
+		panic(err)
+	}
+}`}, 3, gosec.NewConfig()}, {[]string{`
 // context match
 package main
 import (
@@ -1213,6 +1811,23 @@ func main() {
 		"Body":     template.URL(a),
 	}
 	t.Execute(os.Stdout, v)
+}`,
+		}, 1, gosec.NewConfig()}, {[]string{
+			`
+package main
+import (
+	"html/template"
+	"os"
+)
+const tmpl = ""
+func main() {
+	a := "something from another place"
+	t := template.Must(template.New("ex").Parse(tmpl))
+	v := map[string]interface{}{
+		"Title":    "Test <b>World</b>",
+		"Style":    template.CSS(a),
+	}
+	t.Execute(os.Stdout, v)
 }`,
 		}, 1, gosec.NewConfig()},
 	}
@@ -1386,6 +2001,33 @@ func main() {
 	log.Printf("Command finished with error: %v", err)
 }
 `}, 1, gosec.NewConfig()},
+		{[]string{`
+// decoding the input does not make it safe
+package main
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"log"
+	"os"
+	"os/exec"
+)
+func main() {
+	script, err := base64.StdEncoding.DecodeString(os.Args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := exec.Command("sh", "-c", string(script)).Run(); err != nil {
+		log.Fatal(err)
+	}
+	name, err := hex.DecodeString(os.Args[2])
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := exec.Command(string(name)).Run(); err != nil {
+		log.Fatal(err)
+	}
+}
+`}, 2, gosec.NewConfig()},
 	}
 
 	// SampleCodeG301 - mkdir permission check
@@ -1535,6 +2177,48 @@ import (
 	"os"
 )
 
+func main() {
+	http.HandleFunc("/bar", func(w http.ResponseWriter, r *http.Request) {
+		title := r.URL.Query().Get("title")
+		body, err := os.ReadFile(title)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+		}
+		fmt.Fprintf(w, "%s", body)
+	})
+	log.Fatal(http.ListenAndServe(":3000", nil))
+}`}, 1, gosec.NewConfig()}, {[]string{`
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+)
+
+func main() {
+	http.HandleFunc("/bar", func(w http.ResponseWriter, r *http.Request) {
+		title := r.URL.Query().Get("title")
+		f, err := os.Create(title)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		defer f.Close()
+		fmt.Fprintf(f, "%s", r.URL.Query().Get("body"))
+	})
+	log.Fatal(http.ListenAndServe(":3000", nil))
+}`}, 1, gosec.NewConfig()}, {[]string{`
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+)
+
 func main() {
 	http.HandleFunc("/bar", func(w http.ResponseWriter, r *http.Request) {
   		title := r.URL.Query().Get("title")